@@ -1,8 +1,15 @@
 package interfaces
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"sync"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/mitchellh/mapstructure"
 	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
@@ -11,18 +18,45 @@ import (
 
 // IPInterfaceGetDataModelONTAP describes the GET record data model using go types for mapping.
 type IPInterfaceGetDataModelONTAP struct {
-	Name    string `mapstructure:"name"`
-	Scope   string `mapstructure:"scope"`
-	SVMName string `mapstructure:"svm.name"`
-	UUID    string `mapstructure:"uuid"`
+	Name          string                            `mapstructure:"name"`
+	Scope         string                            `mapstructure:"scope"`
+	SVMName       string                            `mapstructure:"svm.name"`
+	UUID          string                            `mapstructure:"uuid"`
+	ServicePolicy *IPInterfaceResourceServicePolicy `mapstructure:"service_policy,omitempty"`
+	FailoverGroup string                            `mapstructure:"failover_group,omitempty"`
+	Enabled       *bool                             `mapstructure:"enabled,omitempty"`
+	DDNSEnabled   *bool                             `mapstructure:"ddns_enabled,omitempty"`
+	Probe         *bool                             `mapstructure:"probe_port,omitempty"`
 }
 
 // IPInterfaceResourceBodyDataModelONTAP describes the body data model using go types for mapping.
 type IPInterfaceResourceBodyDataModelONTAP struct {
-	Name     string                      `mapstructure:"name"`
-	SVM      Vserver                     `mapstructure:"svm"`
-	IP       IPInterfaceResourceIP       `mapstructure:"ip"`
-	Location IPInterfaceResourceLocation `mapstructure:"location"`
+	Name          string                            `mapstructure:"name"`
+	SVM           Vserver                           `mapstructure:"svm"`
+	IP            IPInterfaceResourceIP             `mapstructure:"ip"`
+	Location      IPInterfaceResourceLocation       `mapstructure:"location"`
+	ServicePolicy *IPInterfaceResourceServicePolicy `mapstructure:"service_policy,omitempty"`
+	FailoverGroup string                            `mapstructure:"failover_group,omitempty"`
+	Enabled       *bool                             `mapstructure:"enabled,omitempty"`
+	DDNSEnabled   *bool                             `mapstructure:"ddns_enabled,omitempty"`
+	Probe         *bool                             `mapstructure:"probe_port,omitempty"`
+}
+
+// IPInterfaceResourceServicePolicy is the body data model for service_policy field
+type IPInterfaceResourceServicePolicy struct {
+	Name string `mapstructure:"name,omitempty"`
+	UUID string `mapstructure:"uuid,omitempty"`
+}
+
+// IPInterfaceResourceBodyUpdateDataModelONTAP describes the fields that can be modified on an existing ip_interface.
+type IPInterfaceResourceBodyUpdateDataModelONTAP struct {
+	IP            *IPInterfaceResourceIP            `mapstructure:"ip,omitempty"`
+	Location      *IPInterfaceResourceLocation      `mapstructure:"location,omitempty"`
+	ServicePolicy *IPInterfaceResourceServicePolicy `mapstructure:"service_policy,omitempty"`
+	FailoverGroup string                            `mapstructure:"failover_group,omitempty"`
+	Enabled       *bool                             `mapstructure:"enabled,omitempty"`
+	DDNSEnabled   *bool                             `mapstructure:"ddns_enabled,omitempty"`
+	Probe         *bool                             `mapstructure:"probe_port,omitempty"`
 }
 
 // IPInterfaceResourceIP is the body data model for IP field
@@ -49,7 +83,10 @@ type IPInterfaceResourceHomePort struct {
 }
 
 // GetIPInterface to get ip_interface info
-func GetIPInterface(errorHandler *utils.ErrorHandler, r restclient.RestClient, name string, svmName string) (*IPInterfaceGetDataModelONTAP, error) {
+func GetIPInterface(ctx context.Context, errorHandler *utils.ErrorHandler, r restclient.RestClient, name string, svmName string) (*IPInterfaceGetDataModelONTAP, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading ip_interface info", fmt.Sprintf("context canceled before GET network/ip/interfaces: %s", err))
+	}
 	api := "network/ip/interfaces"
 	query := r.NewQuery()
 	query.Set("name", name)
@@ -59,8 +96,8 @@ func GetIPInterface(errorHandler *utils.ErrorHandler, r restclient.RestClient, n
 		query.Set("svm.name", svmName)
 		query.Set("scope", "svm")
 	}
-	query.Fields([]string{"name", "svm.name", "ip", "scope"})
-	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	query.Fields([]string{"name", "svm.name", "ip", "scope", "service_policy", "failover_group", "enabled", "ddns_enabled", "probe_port"})
+	statusCode, response, err := r.GetNilOrOneRecord(ctx, api, query, nil)
 	if err == nil && response == nil {
 		err = fmt.Errorf("no response for GET %s", api)
 	}
@@ -73,15 +110,18 @@ func GetIPInterface(errorHandler *utils.ErrorHandler, r restclient.RestClient, n
 		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
 			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
 	}
-	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read ip_interface data source: %#v", dataONTAP))
+	tflog.Debug(ctx, fmt.Sprintf("Read ip_interface data source: %#v", dataONTAP))
 	return &dataONTAP, nil
 }
 
 // GetIPInterfaces to get ip_interface info for all resources matching a filter
-func GetIPInterfaces(errorHandler *utils.ErrorHandler, r restclient.RestClient, filter *IPInterfaceGetDataModelONTAP) ([]IPInterfaceGetDataModelONTAP, error) {
+func GetIPInterfaces(ctx context.Context, errorHandler *utils.ErrorHandler, r restclient.RestClient, filter *IPInterfaceGetDataModelONTAP) ([]IPInterfaceGetDataModelONTAP, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading ip_interfaces info", fmt.Sprintf("context canceled before GET network/ip/interfaces: %s", err))
+	}
 	api := "network/ip/interfaces"
 	query := r.NewQuery()
-	query.Fields([]string{"name", "svm.name", "ip", "scope"})
+	query.Fields([]string{"name", "svm.name", "ip", "scope", "service_policy", "failover_group", "enabled", "ddns_enabled", "probe_port"})
 	if filter != nil {
 		var filterMap map[string]interface{}
 		if err := mapstructure.Decode(filter, &filterMap); err != nil {
@@ -89,7 +129,7 @@ func GetIPInterfaces(errorHandler *utils.ErrorHandler, r restclient.RestClient,
 		}
 		query.SetValues(filterMap)
 	}
-	statusCode, response, err := r.GetZeroOrMoreRecords(api, query, nil)
+	statusCode, response, err := r.GetZeroOrMoreRecords(ctx, api, query, nil)
 	if err == nil && response == nil {
 		err = fmt.Errorf("no response for GET %s", api)
 	}
@@ -106,12 +146,15 @@ func GetIPInterfaces(errorHandler *utils.ErrorHandler, r restclient.RestClient,
 		}
 		dataONTAP = append(dataONTAP, record)
 	}
-	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read ip_interface data source: %#v", dataONTAP))
+	tflog.Debug(ctx, fmt.Sprintf("Read ip_interface data source: %#v", dataONTAP))
 	return dataONTAP, nil
 }
 
 // CreateIPInterface to create ip_interface
-func CreateIPInterface(errorHandler *utils.ErrorHandler, r restclient.RestClient, body IPInterfaceResourceBodyDataModelONTAP) (*IPInterfaceGetDataModelONTAP, error) {
+func CreateIPInterface(ctx context.Context, errorHandler *utils.ErrorHandler, r restclient.RestClient, body IPInterfaceResourceBodyDataModelONTAP) (*IPInterfaceGetDataModelONTAP, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, errorHandler.MakeAndReportError("error creating ip_interface", fmt.Sprintf("context canceled before POST network/ip/interfaces: %s", err))
+	}
 	api := "network/ip/interfaces"
 	var bodyMap map[string]interface{}
 	if err := mapstructure.Decode(body, &bodyMap); err != nil {
@@ -119,25 +162,370 @@ func CreateIPInterface(errorHandler *utils.ErrorHandler, r restclient.RestClient
 	}
 	query := r.NewQuery()
 	query.Add("return_records", "true")
-	statusCode, response, err := r.CallCreateMethod(api, query, bodyMap)
+	statusCode, response, err := r.CallCreateMethod(ctx, api, query, bodyMap)
 	if err != nil {
 		return nil, errorHandler.MakeAndReportError("error creating ip_interface", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
 	}
+	if statusCode == http.StatusAccepted {
+		jobUUID, jobErr := extractJobUUID(response)
+		if jobErr != nil {
+			return nil, errorHandler.MakeAndReportError("error creating ip_interface", fmt.Sprintf("error on POST %s: async response without a job uuid: %s", api, jobErr))
+		}
+		if err := waitOnJob(ctx, errorHandler, r, jobUUID); err != nil {
+			return nil, err
+		}
+		return GetIPInterface(ctx, errorHandler, r, body.Name, body.SVM.Name)
+	}
+	if len(response.Records) == 0 {
+		return nil, errorHandler.MakeAndReportError("error creating ip_interface", fmt.Sprintf("no records returned from POST %s, statusCode %d", api, statusCode))
+	}
 
 	var dataONTAP IPInterfaceGetDataModelONTAP
 	if err := mapstructure.Decode(response.Records[0], &dataONTAP); err != nil {
 		return nil, errorHandler.MakeAndReportError("error decoding ip_interface info", fmt.Sprintf("error on decode storage/ip_interfaces info: %s, statusCode %d, response %#v", err, statusCode, response))
 	}
-	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Create ip_interface source - udata: %#v", dataONTAP))
+	tflog.Debug(ctx, fmt.Sprintf("Create ip_interface source - udata: %#v", dataONTAP))
 	return &dataONTAP, nil
 }
 
+// UpdateIPInterface to update ip_interface
+func UpdateIPInterface(ctx context.Context, errorHandler *utils.ErrorHandler, r restclient.RestClient, uuid string, body IPInterfaceResourceBodyUpdateDataModelONTAP) error {
+	if err := ctx.Err(); err != nil {
+		return errorHandler.MakeAndReportError("error updating ip_interface", fmt.Sprintf("context canceled before PATCH network/ip/interfaces: %s", err))
+	}
+	api := "network/ip/interfaces/" + uuid
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding ip_interface body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	statusCode, response, err := r.CallModifyMethod(ctx, api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating ip_interface", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	if statusCode == http.StatusAccepted {
+		jobUUID, jobErr := extractJobUUID(response)
+		if jobErr != nil {
+			return errorHandler.MakeAndReportError("error updating ip_interface", fmt.Sprintf("error on PATCH %s: async response without a job uuid: %s", api, jobErr))
+		}
+		return waitOnJob(ctx, errorHandler, r, jobUUID)
+	}
+	return nil
+}
+
+// MigrateIPInterface to move ip_interface to a different node/port
+func MigrateIPInterface(ctx context.Context, errorHandler *utils.ErrorHandler, r restclient.RestClient, uuid string, node string, port string) error {
+	if err := ctx.Err(); err != nil {
+		return errorHandler.MakeAndReportError("error migrating ip_interface", fmt.Sprintf("context canceled before PATCH network/ip/interfaces: %s", err))
+	}
+	api := "network/ip/interfaces/" + uuid
+	bodyMap := map[string]interface{}{
+		"location": map[string]interface{}{
+			"node": map[string]interface{}{
+				"name": node,
+			},
+			"port": map[string]interface{}{
+				"name": port,
+				"node": map[string]interface{}{
+					"name": node,
+				},
+			},
+		},
+	}
+	statusCode, response, err := r.CallModifyMethod(ctx, api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error migrating ip_interface", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	if statusCode == http.StatusAccepted {
+		jobUUID, jobErr := extractJobUUID(response)
+		if jobErr != nil {
+			return errorHandler.MakeAndReportError("error migrating ip_interface", fmt.Sprintf("error on PATCH %s: async response without a job uuid: %s", api, jobErr))
+		}
+		return waitOnJob(ctx, errorHandler, r, jobUUID)
+	}
+	return nil
+}
+
+// RevertIPInterface to move ip_interface back to its home node/port
+func RevertIPInterface(ctx context.Context, errorHandler *utils.ErrorHandler, r restclient.RestClient, uuid string) error {
+	if err := ctx.Err(); err != nil {
+		return errorHandler.MakeAndReportError("error reverting ip_interface to home", fmt.Sprintf("context canceled before PATCH network/ip/interfaces: %s", err))
+	}
+	api := "network/ip/interfaces/" + uuid
+	bodyMap := map[string]interface{}{
+		"location": map[string]interface{}{
+			"is_home": true,
+		},
+	}
+	statusCode, response, err := r.CallModifyMethod(ctx, api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error reverting ip_interface to home", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	if statusCode == http.StatusAccepted {
+		jobUUID, jobErr := extractJobUUID(response)
+		if jobErr != nil {
+			return errorHandler.MakeAndReportError("error reverting ip_interface to home", fmt.Sprintf("error on PATCH %s: async response without a job uuid: %s", api, jobErr))
+		}
+		return waitOnJob(ctx, errorHandler, r, jobUUID)
+	}
+	return nil
+}
+
 // DeleteIPInterface to delete ip_interface
-func DeleteIPInterface(errorHandler *utils.ErrorHandler, r restclient.RestClient, uuid string) error {
+func DeleteIPInterface(ctx context.Context, errorHandler *utils.ErrorHandler, r restclient.RestClient, uuid string) error {
+	if err := ctx.Err(); err != nil {
+		return errorHandler.MakeAndReportError("error deleting ip_interface", fmt.Sprintf("context canceled before DELETE network/ip/interfaces: %s", err))
+	}
 	api := "network/ip/interfaces"
-	statusCode, _, err := r.CallDeleteMethod(api+"/"+uuid, nil, nil)
+	statusCode, response, err := r.CallDeleteMethod(ctx, api+"/"+uuid, nil, nil)
 	if err != nil {
 		return errorHandler.MakeAndReportError("error deleting ip_interface", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
 	}
+	if statusCode == http.StatusAccepted {
+		jobUUID, jobErr := extractJobUUID(response)
+		if jobErr != nil {
+			return errorHandler.MakeAndReportError("error deleting ip_interface", fmt.Sprintf("error on DELETE %s: async response without a job uuid: %s", api, jobErr))
+		}
+		return waitOnJob(ctx, errorHandler, r, jobUUID)
+	}
 	return nil
+}
+
+// reconcileWorkers bounds how many ip_interface creates/updates/deletes run at once during a reconcile.
+const reconcileWorkers = 8
+
+// reconcileMaxRetries is the number of attempts made for each ip_interface operation before giving up.
+const reconcileMaxRetries = 4
+
+// IPInterfaceReconcileResult reports the outcome of one create, update or delete made by ReconcileIPInterfaces.
+type IPInterfaceReconcileResult struct {
+	Name   string
+	Action string
+	Error  error
+}
+
+// ipInterfacePlanItem describes one action ReconcileIPInterfaces needs to take for a single
+// ip_interface, as decided by planIPInterfaceReconcile.
+type ipInterfacePlanItem struct {
+	Name     string
+	Action   string
+	Desired  IPInterfaceResourceBodyDataModelONTAP
+	Existing IPInterfaceGetDataModelONTAP
+}
+
+// planIPInterfaceReconcile diffs desired against existing ip_interfaces by name and decides whether
+// each one needs to be created, updated or deleted. It has no side effects, so the reconcile plan can
+// be unit tested without a RestClient.
+func planIPInterfaceReconcile(desired []IPInterfaceResourceBodyDataModelONTAP, existing []IPInterfaceGetDataModelONTAP) []ipInterfacePlanItem {
+	existingByName := make(map[string]IPInterfaceGetDataModelONTAP, len(existing))
+	for _, record := range existing {
+		existingByName[record.Name] = record
+	}
+	desiredByName := make(map[string]struct{}, len(desired))
+	for _, record := range desired {
+		desiredByName[record.Name] = struct{}{}
+	}
+
+	var plan []ipInterfacePlanItem
+	for _, record := range desired {
+		if existingRecord, ok := existingByName[record.Name]; ok {
+			plan = append(plan, ipInterfacePlanItem{Name: record.Name, Action: "update", Desired: record, Existing: existingRecord})
+		} else {
+			plan = append(plan, ipInterfacePlanItem{Name: record.Name, Action: "create", Desired: record})
+		}
+	}
+	for _, record := range existing {
+		if _, ok := desiredByName[record.Name]; ok {
+			continue
+		}
+		plan = append(plan, ipInterfacePlanItem{Name: record.Name, Action: "delete", Existing: record})
+	}
+	return plan
+}
+
+// ReconcileIPInterfaces diffs desired against the cluster's current ip_interfaces and issues the
+// necessary creates, updates and deletes concurrently, retrying transient failures with backoff.
+func ReconcileIPInterfaces(ctx context.Context, errorHandler *utils.ErrorHandler, r restclient.RestClient, desired []IPInterfaceResourceBodyDataModelONTAP) ([]IPInterfaceReconcileResult, error) {
+	existing, err := GetIPInterfaces(ctx, errorHandler, r, nil)
+	if err != nil {
+		return nil, err
+	}
+	plan := planIPInterfaceReconcile(desired, existing)
+
+	// errorHandler accumulates diagnostics on the caller's behalf and is not safe for concurrent use.
+	// Rather than serialize every worker behind a lock around its whole REST call (and any job-wait it
+	// does), each job reports through a scratch ErrorHandler of its own so concurrent workers never touch
+	// shared state; errors are merged into the real errorHandler sequentially once all jobs finish.
+	type job func() IPInterfaceReconcileResult
+	jobs := make([]job, 0, len(plan))
+	for _, item := range plan {
+		item := item
+		switch item.Action {
+		case "update":
+			jobs = append(jobs, func() IPInterfaceReconcileResult {
+				var updateBody IPInterfaceResourceBodyUpdateDataModelONTAP
+				updateBody.IP = &item.Desired.IP
+				if item.Desired.Location.HomeNode != nil || item.Desired.Location.HomePort != nil {
+					updateBody.Location = &item.Desired.Location
+				}
+				updateBody.ServicePolicy = item.Desired.ServicePolicy
+				updateBody.FailoverGroup = item.Desired.FailoverGroup
+				updateBody.Enabled = item.Desired.Enabled
+				updateBody.DDNSEnabled = item.Desired.DDNSEnabled
+				updateBody.Probe = item.Desired.Probe
+				var jobDiags diag.Diagnostics
+				jobErrorHandler := utils.NewErrorHandler(ctx, &jobDiags)
+				err := reconcileWithRetry(func() error {
+					return UpdateIPInterface(ctx, jobErrorHandler, r, item.Existing.UUID, updateBody)
+				})
+				return IPInterfaceReconcileResult{Name: item.Name, Action: "update", Error: err}
+			})
+		case "create":
+			jobs = append(jobs, func() IPInterfaceReconcileResult {
+				var jobDiags diag.Diagnostics
+				jobErrorHandler := utils.NewErrorHandler(ctx, &jobDiags)
+				err := reconcileWithRetry(func() error {
+					_, err := CreateIPInterface(ctx, jobErrorHandler, r, item.Desired)
+					return err
+				})
+				return IPInterfaceReconcileResult{Name: item.Name, Action: "create", Error: err}
+			})
+		case "delete":
+			jobs = append(jobs, func() IPInterfaceReconcileResult {
+				var jobDiags diag.Diagnostics
+				jobErrorHandler := utils.NewErrorHandler(ctx, &jobDiags)
+				err := reconcileWithRetry(func() error {
+					return DeleteIPInterface(ctx, jobErrorHandler, r, item.Existing.UUID)
+				})
+				return IPInterfaceReconcileResult{Name: item.Name, Action: "delete", Error: err}
+			})
+		}
+	}
+
+	results := make([]IPInterfaceReconcileResult, len(jobs))
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, reconcileWorkers)
+	for i, j := range jobs {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, j job) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			results[i] = j()
+		}(i, j)
+	}
+	wg.Wait()
+
+	for _, res := range results {
+		if res.Error != nil {
+			errorHandler.MakeAndReportError(fmt.Sprintf("error reconciling ip_interface %q", res.Name), res.Error.Error())
+		}
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Reconciled ip_interfaces: %#v", results))
+	return results, nil
+}
+
+// reconcileWithRetry retries a reconcile operation with exponential backoff while the error looks transient.
+func reconcileWithRetry(op func() error) error {
+	var err error
+	backoff := 500 * time.Millisecond
+	for attempt := 0; attempt < reconcileMaxRetries; attempt++ {
+		if err = op(); err == nil {
+			return nil
+		}
+		if !isTransientError(err) {
+			return err
+		}
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+	return err
+}
+
+// statusCodeRegexp pulls the ONTAP REST status code out of the error messages produced by this file,
+// which all embed it as "statusCode %d".
+var statusCodeRegexp = regexp.MustCompile(`statusCode (\d+)`)
+
+// isTransientError reports whether err looks like a 429 or 5xx ONTAP REST response worth retrying.
+func isTransientError(err error) bool {
+	if err == nil {
+		return false
+	}
+	match := statusCodeRegexp.FindStringSubmatch(err.Error())
+	if match == nil {
+		return false
+	}
+	code, convErr := strconv.Atoi(match[1])
+	if convErr != nil {
+		return false
+	}
+	return code == http.StatusTooManyRequests || code >= http.StatusInternalServerError
+}
+
+// jobPollInterval is how often an async ONTAP job is polled for completion.
+const jobPollInterval = 2 * time.Second
+
+// jobPollTimeout bounds how long waitOnJob waits for a single async job to finish, absent
+// a shorter deadline already set on the caller's context.
+const jobPollTimeout = 5 * time.Minute
+
+// extractJobUUID pulls job.uuid out of a 202 Accepted ONTAP response so the caller can poll it to
+// completion with waitOnJob.
+func extractJobUUID(response interface{}) (string, error) {
+	var async struct {
+		Job struct {
+			UUID string `mapstructure:"uuid"`
+		} `mapstructure:"job"`
+	}
+	if err := mapstructure.Decode(response, &async); err != nil {
+		return "", err
+	}
+	if async.Job.UUID == "" {
+		return "", fmt.Errorf("no job.uuid in response %#v", response)
+	}
+	return async.Job.UUID, nil
+}
+
+// DeadlineTimer returns a ctx that is canceled after timeout, unless the parent ctx already carries an
+// earlier deadline, so a per-call timeout never loosens a caller-supplied one. Callers use it to apply
+// their own per-operation deadline (e.g. the provider block's configured read/write timeouts) around a
+// single GetIPInterface/CreateIPInterface/.../ReconcileIPInterfaces call.
+func DeadlineTimer(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if existing, ok := ctx.Deadline(); ok && time.Until(existing) < timeout {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+// waitOnJob polls cluster/jobs/{uuid} until the job reaches a terminal state or ctx is done.
+func waitOnJob(ctx context.Context, errorHandler *utils.ErrorHandler, r restclient.RestClient, jobUUID string) error {
+	ctx, cancel := DeadlineTimer(ctx, jobPollTimeout)
+	defer cancel()
+
+	api := "cluster/jobs/" + jobUUID
+	for {
+		statusCode, response, err := r.GetNilOrOneRecord(ctx, api, r.NewQuery(), nil)
+		if err != nil {
+			return errorHandler.MakeAndReportError("error polling ip_interface job", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+		}
+		var job struct {
+			State   string `mapstructure:"state"`
+			Message string `mapstructure:"message"`
+		}
+		if err := mapstructure.Decode(response, &job); err != nil {
+			return errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+				fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+		}
+		switch job.State {
+		case "success":
+			return nil
+		case "failure", "error":
+			return errorHandler.MakeAndReportError("ip_interface job failed", fmt.Sprintf("job %s ended in state %s: %s", jobUUID, job.State, job.Message))
+		}
+		select {
+		case <-ctx.Done():
+			return errorHandler.MakeAndReportError("ip_interface job timed out", fmt.Sprintf("job %s still in state %s: %s", jobUUID, job.State, ctx.Err()))
+		case <-time.After(jobPollInterval):
+		}
+	}
 }
\ No newline at end of file
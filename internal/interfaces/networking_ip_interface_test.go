@@ -0,0 +1,124 @@
+package interfaces
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestPlanIPInterfaceReconcile(t *testing.T) {
+	desired := []IPInterfaceResourceBodyDataModelONTAP{
+		{Name: "lif1"}, // unchanged from existing name, should become an update
+		{Name: "lif2"}, // not present yet, should become a create
+	}
+	existing := []IPInterfaceGetDataModelONTAP{
+		{Name: "lif1", UUID: "uuid-1"},
+		{Name: "lif3", UUID: "uuid-3"}, // not in desired, should become a delete
+	}
+
+	plan := planIPInterfaceReconcile(desired, existing)
+
+	actions := make(map[string]string, len(plan))
+	for _, item := range plan {
+		actions[item.Name] = item.Action
+	}
+	want := map[string]string{"lif1": "update", "lif2": "create", "lif3": "delete"}
+	if len(actions) != len(want) {
+		t.Fatalf("planIPInterfaceReconcile() = %#v, want %#v", actions, want)
+	}
+	for name, wantAction := range want {
+		if gotAction := actions[name]; gotAction != wantAction {
+			t.Errorf("action for %q = %q, want %q", name, gotAction, wantAction)
+		}
+	}
+
+	for _, item := range plan {
+		if item.Name == "lif1" && item.Existing.UUID != "uuid-1" {
+			t.Errorf("update item for lif1 carries wrong existing record: %#v", item.Existing)
+		}
+		if item.Name == "lif3" && item.Existing.UUID != "uuid-3" {
+			t.Errorf("delete item for lif3 carries wrong existing record: %#v", item.Existing)
+		}
+	}
+}
+
+func TestPlanIPInterfaceReconcileNoChanges(t *testing.T) {
+	records := []IPInterfaceResourceBodyDataModelONTAP{{Name: "lif1"}}
+	existing := []IPInterfaceGetDataModelONTAP{{Name: "lif1", UUID: "uuid-1"}}
+
+	plan := planIPInterfaceReconcile(records, existing)
+
+	if len(plan) != 1 || plan[0].Action != "update" {
+		t.Fatalf("planIPInterfaceReconcile() = %#v, want a single update", plan)
+	}
+}
+
+func TestIsTransientError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil error", nil, false},
+		{"429 too many requests", fmt.Errorf("error on POST network/ip/interfaces: boom, statusCode %d", http.StatusTooManyRequests), true},
+		{"500 internal server error", fmt.Errorf("error on PATCH network/ip/interfaces: boom, statusCode %d", http.StatusInternalServerError), true},
+		{"503 service unavailable", fmt.Errorf("error on DELETE network/ip/interfaces: boom, statusCode %d", http.StatusServiceUnavailable), true},
+		{"400 bad request is not transient", fmt.Errorf("error on POST network/ip/interfaces: boom, statusCode %d", http.StatusBadRequest), false},
+		{"404 not found is not transient", fmt.Errorf("error on GET network/ip/interfaces: boom, statusCode %d", http.StatusNotFound), false},
+		{"no status code in message", errors.New("connection reset by peer"), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isTransientError(tt.err); got != tt.want {
+				t.Errorf("isTransientError(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReconcileWithRetrySucceedsAfterTransientErrors(t *testing.T) {
+	attempts := 0
+	err := reconcileWithRetry(func() error {
+		attempts++
+		if attempts < reconcileMaxRetries {
+			return fmt.Errorf("error on PATCH network/ip/interfaces: overloaded, statusCode %d", http.StatusServiceUnavailable)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("reconcileWithRetry() = %v, want nil", err)
+	}
+	if attempts != reconcileMaxRetries {
+		t.Errorf("attempts = %d, want %d", attempts, reconcileMaxRetries)
+	}
+}
+
+func TestReconcileWithRetryStopsOnNonTransientError(t *testing.T) {
+	attempts := 0
+	wantErr := fmt.Errorf("error on POST network/ip/interfaces: bad request, statusCode %d", http.StatusBadRequest)
+	err := reconcileWithRetry(func() error {
+		attempts++
+		return wantErr
+	})
+	if !errors.Is(err, wantErr) && err.Error() != wantErr.Error() {
+		t.Fatalf("reconcileWithRetry() = %v, want %v", err, wantErr)
+	}
+	if attempts != 1 {
+		t.Errorf("attempts = %d, want 1 (non-transient errors should not be retried)", attempts)
+	}
+}
+
+func TestReconcileWithRetryGivesUpAfterMaxRetries(t *testing.T) {
+	attempts := 0
+	err := reconcileWithRetry(func() error {
+		attempts++
+		return fmt.Errorf("error on DELETE network/ip/interfaces: overloaded, statusCode %d", http.StatusTooManyRequests)
+	})
+	if err == nil {
+		t.Fatal("reconcileWithRetry() = nil, want an error after exhausting retries")
+	}
+	if attempts != reconcileMaxRetries {
+		t.Errorf("attempts = %d, want %d", attempts, reconcileMaxRetries)
+	}
+}
@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"time"
+
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+)
+
+// defaultReadTimeout and defaultWriteTimeout bound a single GET/POST/PATCH/DELETE-and-job-wait
+// operation when the provider block doesn't configure read_timeout/write_timeout.
+const (
+	defaultReadTimeout  = 30 * time.Second
+	defaultWriteTimeout = 5 * time.Minute
+)
+
+// Config holds the resources/data sources shared, configured connection to the ONTAP cluster, plus the
+// per-operation deadlines configured on the provider block.
+type Config struct {
+	RestClient   restclient.RestClient
+	ReadTimeout  time.Duration
+	WriteTimeout time.Duration
+}
+
+// readTimeout returns the configured read timeout, or defaultReadTimeout if unset.
+func (c Config) readTimeout() time.Duration {
+	if c.ReadTimeout == 0 {
+		return defaultReadTimeout
+	}
+	return c.ReadTimeout
+}
+
+// writeTimeout returns the configured write timeout, or defaultWriteTimeout if unset.
+func (c Config) writeTimeout() time.Duration {
+	if c.WriteTimeout == 0 {
+		return defaultWriteTimeout
+	}
+	return c.WriteTimeout
+}
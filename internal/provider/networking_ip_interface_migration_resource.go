@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// NetworkingIPInterfaceMigrationResource defines the resource implementation.
+type NetworkingIPInterfaceMigrationResource struct {
+	config Config
+}
+
+// NewNetworkingIPInterfaceMigrationResource is a helper function to simplify the provider implementation.
+func NewNetworkingIPInterfaceMigrationResource() resource.Resource {
+	return &NetworkingIPInterfaceMigrationResource{}
+}
+
+// NetworkingIPInterfaceMigrationResourceModel describes the resource data model.
+type NetworkingIPInterfaceMigrationResourceModel struct {
+	CxProfileName types.String `tfsdk:"cx_profile_name"`
+	IPInterfaceID types.String `tfsdk:"ip_interface_id"`
+	Node          types.String `tfsdk:"node"`
+	Port          types.String `tfsdk:"port"`
+	ID            types.String `tfsdk:"id"`
+}
+
+// Metadata returns the resource type name.
+func (r *NetworkingIPInterfaceMigrationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_networking_ip_interface_migration"
+}
+
+// Schema defines the schema for the resource.
+//
+// This resource has no steady state of its own: creating it migrates the referenced ip_interface to
+// node/port, and destroying it reverts the ip_interface back to its configured home. It does not manage
+// the ip_interface's other attributes, so it can be layered on top of netapp-ontap_networking_ip_interface.
+func (r *NetworkingIPInterfaceMigrationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Migrates an ip_interface to a node/port and reverts it home on destroy",
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Required:            true,
+			},
+			"ip_interface_id": schema.StringAttribute{
+				MarkdownDescription: "UUID of the ip_interface to migrate",
+				Required:            true,
+			},
+			"node": schema.StringAttribute{
+				MarkdownDescription: "node to migrate the ip_interface to",
+				Required:            true,
+			},
+			"port": schema.StringAttribute{
+				MarkdownDescription: "port on node to migrate the ip_interface to",
+				Required:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "same as ip_interface_id",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *NetworkingIPInterfaceMigrationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("expected provider.Config, got: %T", req.ProviderData))
+		return
+	}
+	r.config = config
+}
+
+// Create migrates the ip_interface to the requested node/port.
+func (r *NetworkingIPInterfaceMigrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data NetworkingIPInterfaceMigrationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := interfaces.DeadlineTimer(ctx, r.config.writeTimeout())
+	defer cancel()
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	if err := interfaces.MigrateIPInterface(ctx, errorHandler, r.config.RestClient, data.IPInterfaceID.ValueString(), data.Node.ValueString(), data.Port.ValueString()); err != nil {
+		return
+	}
+
+	data.ID = data.IPInterfaceID
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read reports the migration as still in effect; ONTAP does not expose a stable "migrated" flag to
+// reconcile against, so this resource trusts Terraform state between applies.
+func (r *NetworkingIPInterfaceMigrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data NetworkingIPInterfaceMigrationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update re-migrates the ip_interface when node or port changes.
+func (r *NetworkingIPInterfaceMigrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data NetworkingIPInterfaceMigrationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := interfaces.DeadlineTimer(ctx, r.config.writeTimeout())
+	defer cancel()
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	if err := interfaces.MigrateIPInterface(ctx, errorHandler, r.config.RestClient, data.IPInterfaceID.ValueString(), data.Node.ValueString(), data.Port.ValueString()); err != nil {
+		return
+	}
+
+	data.ID = data.IPInterfaceID
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete reverts the ip_interface back to its configured home node/port.
+func (r *NetworkingIPInterfaceMigrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data NetworkingIPInterfaceMigrationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := interfaces.DeadlineTimer(ctx, r.config.writeTimeout())
+	defer cancel()
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	if err := interfaces.RevertIPInterface(ctx, errorHandler, r.config.RestClient, data.IPInterfaceID.ValueString()); err != nil {
+		return
+	}
+}
@@ -0,0 +1,254 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// NetworkingIPInterfaceResource defines the resource implementation.
+type NetworkingIPInterfaceResource struct {
+	config Config
+}
+
+// NewNetworkingIPInterfaceResource is a helper function to simplify the provider implementation.
+func NewNetworkingIPInterfaceResource() resource.Resource {
+	return &NetworkingIPInterfaceResource{}
+}
+
+// NetworkingIPInterfaceResourceModel describes the resource data model.
+type NetworkingIPInterfaceResourceModel struct {
+	CxProfileName types.String `tfsdk:"cx_profile_name"`
+	Name          types.String `tfsdk:"name"`
+	SVMName       types.String `tfsdk:"svm_name"`
+	Address       types.String `tfsdk:"address"`
+	Netmask       types.Int64  `tfsdk:"netmask"`
+	HomeNode      types.String `tfsdk:"home_node"`
+	HomePort      types.String `tfsdk:"home_port"`
+	ServicePolicy types.String `tfsdk:"service_policy"`
+	FailoverGroup types.String `tfsdk:"failover_group"`
+	Enabled       types.Bool   `tfsdk:"enabled"`
+	ID            types.String `tfsdk:"id"`
+}
+
+// Metadata returns the resource type name.
+func (r *NetworkingIPInterfaceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_networking_ip_interface"
+}
+
+// Schema defines the schema for the resource.
+func (r *NetworkingIPInterfaceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "IP interface resource",
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "IP interface name",
+				Required:            true,
+			},
+			"svm_name": schema.StringAttribute{
+				MarkdownDescription: "SVM name",
+				Required:            true,
+			},
+			"address": schema.StringAttribute{
+				MarkdownDescription: "IP address",
+				Required:            true,
+			},
+			"netmask": schema.Int64Attribute{
+				MarkdownDescription: "netmask length",
+				Required:            true,
+			},
+			"home_node": schema.StringAttribute{
+				MarkdownDescription: "home node name",
+				Optional:            true,
+			},
+			"home_port": schema.StringAttribute{
+				MarkdownDescription: "home port name",
+				Optional:            true,
+			},
+			"service_policy": schema.StringAttribute{
+				MarkdownDescription: "service policy name",
+				Optional:            true,
+			},
+			"failover_group": schema.StringAttribute{
+				MarkdownDescription: "failover group name",
+				Optional:            true,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "administrative state",
+				Optional:            true,
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "IP interface UUID",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *NetworkingIPInterfaceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("expected provider.Config, got: %T", req.ProviderData))
+		return
+	}
+	r.config = config
+}
+
+func (r *NetworkingIPInterfaceResource) bodyFromModel(data *NetworkingIPInterfaceResourceModel) interfaces.IPInterfaceResourceBodyDataModelONTAP {
+	body := interfaces.IPInterfaceResourceBodyDataModelONTAP{
+		Name: data.Name.ValueString(),
+		SVM:  interfaces.Vserver{Name: data.SVMName.ValueString()},
+		IP: interfaces.IPInterfaceResourceIP{
+			Address: data.Address.ValueString(),
+			Netmask: data.Netmask.ValueInt64(),
+		},
+	}
+	if data.HomeNode.ValueString() != "" {
+		body.Location.HomeNode = &interfaces.IPInterfaceResourceHomeNode{Name: data.HomeNode.ValueString()}
+	}
+	if data.HomePort.ValueString() != "" {
+		body.Location.HomePort = &interfaces.IPInterfaceResourceHomePort{
+			Name: data.HomePort.ValueString(),
+			Node: interfaces.IPInterfaceResourceHomeNode{Name: data.HomeNode.ValueString()},
+		}
+	}
+	if data.ServicePolicy.ValueString() != "" {
+		body.ServicePolicy = &interfaces.IPInterfaceResourceServicePolicy{Name: data.ServicePolicy.ValueString()}
+	}
+	if data.FailoverGroup.ValueString() != "" {
+		body.FailoverGroup = data.FailoverGroup.ValueString()
+	}
+	if !data.Enabled.IsNull() && !data.Enabled.IsUnknown() {
+		enabled := data.Enabled.ValueBool()
+		body.Enabled = &enabled
+	}
+	return body
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *NetworkingIPInterfaceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data NetworkingIPInterfaceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := interfaces.DeadlineTimer(ctx, r.config.writeTimeout())
+	defer cancel()
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	record, err := interfaces.CreateIPInterface(ctx, errorHandler, r.config.RestClient, r.bodyFromModel(&data))
+	if err != nil {
+		return
+	}
+
+	data.ID = types.StringValue(record.UUID)
+	data.Enabled = types.BoolPointerValue(record.Enabled)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *NetworkingIPInterfaceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data NetworkingIPInterfaceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := interfaces.DeadlineTimer(ctx, r.config.readTimeout())
+	defer cancel()
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	record, err := interfaces.GetIPInterface(ctx, errorHandler, r.config.RestClient, data.Name.ValueString(), data.SVMName.ValueString())
+	if err != nil {
+		return
+	}
+	if record == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.ID = types.StringValue(record.UUID)
+	data.Enabled = types.BoolPointerValue(record.Enabled)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource in place instead of destroying and recreating it, so a changed address,
+// netmask, home location, service policy or administrative state is applied via PATCH.
+func (r *NetworkingIPInterfaceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data NetworkingIPInterfaceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var state NetworkingIPInterfaceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := r.bodyFromModel(&data)
+	updateBody := interfaces.IPInterfaceResourceBodyUpdateDataModelONTAP{
+		IP:            &body.IP,
+		ServicePolicy: body.ServicePolicy,
+		FailoverGroup: body.FailoverGroup,
+		Enabled:       body.Enabled,
+	}
+	if body.Location.HomeNode != nil || body.Location.HomePort != nil {
+		updateBody.Location = &body.Location
+	}
+
+	ctx, cancel := interfaces.DeadlineTimer(ctx, r.config.writeTimeout())
+	defer cancel()
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	if err := interfaces.UpdateIPInterface(ctx, errorHandler, r.config.RestClient, state.ID.ValueString(), updateBody); err != nil {
+		return
+	}
+
+	record, err := interfaces.GetIPInterface(ctx, errorHandler, r.config.RestClient, data.Name.ValueString(), data.SVMName.ValueString())
+	if err != nil {
+		return
+	}
+
+	data.ID = state.ID
+	data.Enabled = types.BoolPointerValue(record.Enabled)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *NetworkingIPInterfaceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data NetworkingIPInterfaceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := interfaces.DeadlineTimer(ctx, r.config.writeTimeout())
+	defer cancel()
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	if err := interfaces.DeleteIPInterface(ctx, errorHandler, r.config.RestClient, data.ID.ValueString()); err != nil {
+		return
+	}
+}
+
+// ImportState imports an existing ip_interface into Terraform state by UUID.
+func (r *NetworkingIPInterfaceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}